@@ -0,0 +1,88 @@
+# This model is part of the IEMS 313 project in Spring 2021 at Northwestern
+# University.
+#
+# AMPL model for the critical path method (CPM) on an activity-on-node
+# project network.
+#
+# A single LP gives the earliest and latest start times of all activities.
+# Activities with zero slack form the critical path(s).
+
+###########################################################################
+# Sets, defining the project network
+###########################################################################
+
+  # Set of activities
+set ACTIVITIES;
+
+  # Set of precedence relations
+  #
+  # The elements of the set are pairs (predecessor, successor). The
+  # successor can only start once the predecessor is finished.
+set PRECEDENCES within (ACTIVITIES cross ACTIVITIES);
+
+###########################################################################
+# Parameters of the activities
+###########################################################################
+
+  # Duration of each activity [in days]
+param duration{ACTIVITIES} >= 0;
+
+###########################################################################
+# Optimization variables
+###########################################################################
+
+  # Earliest start time of each activity [in days]
+var early_start{ACTIVITIES}, >= 0;
+  # Latest start time of each activity that does not delay the project
+  # [in days]
+var late_start{ACTIVITIES}, >= 0;
+  # Project completion time [in days]
+var project_duration;
+
+  # Earliest and latest finish times [in days]
+var early_finish{a in ACTIVITIES} = early_start[a] + duration[a];
+var late_finish{a in ACTIVITIES} = late_start[a] + duration[a];
+  # Total slack of each activity [in days]
+  # The critical activities are those with zero slack.
+var slack{a in ACTIVITIES} = late_start[a] - early_start[a];
+
+###########################################################################
+# Objective function
+###########################################################################
+
+  # Minimize the project duration, push early starts down and late starts
+  # up.
+  #
+  # Extending the project by one day raises the sum of the late starts by
+  # at most card(ACTIVITIES) days, so the weight on the project duration
+  # makes sure the shortest project duration is chosen first.
+minimize schedule:
+    (card(ACTIVITIES) + 1) * project_duration
+    + sum{a in ACTIVITIES} early_start[a]
+    - sum{a in ACTIVITIES} late_start[a];
+
+###########################################################################
+# Precedence constraints
+###########################################################################
+
+  # Precedence for the earliest start schedule
+subject to early_precedence{(i,j) in PRECEDENCES}:
+    early_start[i] + duration[i] <= early_start[j];
+
+  # Precedence for the latest start schedule
+subject to late_precedence{(i,j) in PRECEDENCES}:
+    late_start[i] + duration[i] <= late_start[j];
+
+###########################################################################
+# Project completion
+###########################################################################
+
+  # Every activity finishes by the end of the project in the earliest
+  # start schedule
+subject to early_completion{a in ACTIVITIES}:
+    early_start[a] + duration[a] <= project_duration;
+
+  # Every activity finishes by the end of the project in the latest
+  # start schedule
+subject to late_completion{a in ACTIVITIES}:
+    late_start[a] + duration[a] <= project_duration;
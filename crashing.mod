@@ -0,0 +1,88 @@
+# This model is part of the IEMS 313 project in Spring 2021 at Northwestern
+# University.
+#
+# AMPL model for the project time-cost tradeoff (crashing) problem on an
+# activity-on-node project network.
+#
+# For a given target project duration, the LP finds the cheapest way to
+# shorten activities so the project finishes on time. Solving it for a
+# range of targets gives the time-cost tradeoff curve. The model only
+# solves a single target_duration; the sweep over targets is left to the
+# caller.
+
+###########################################################################
+# Sets, defining the project network
+###########################################################################
+
+  # Set of activities
+set ACTIVITIES;
+
+  # Set of precedence relations
+  #
+  # The elements of the set are pairs (predecessor, successor). The
+  # successor can only start once the predecessor is finished.
+set PRECEDENCES within (ACTIVITIES cross ACTIVITIES);
+
+###########################################################################
+# Parameters of the activities
+###########################################################################
+
+  # Normal duration of each activity [in days]
+param normal_duration{ACTIVITIES} >= 0;
+  # Shortest possible (crash) duration of each activity [in days]
+param crash_duration{a in ACTIVITIES} >= 0, <= normal_duration[a];
+  # Cost of each activity at its normal duration [in $]
+param normal_cost{ACTIVITIES} >= 0;
+  # Cost of each activity at its crash duration [in $]
+param crash_cost{a in ACTIVITIES} >= normal_cost[a];
+  # Cost of shortening an activity by one day (computed from the above)
+  # [in $/day]
+param crash_slope{a in ACTIVITIES} :=
+    if normal_duration[a] > crash_duration[a]
+    then (crash_cost[a] - normal_cost[a])
+         / (normal_duration[a] - crash_duration[a])
+    else 0;
+
+###########################################################################
+# Parameters defining the target
+###########################################################################
+
+  # Required project completion time [in days]
+param target_duration >= 0;
+
+###########################################################################
+# Optimization variables
+###########################################################################
+
+  # Start time of each activity [in days]
+var start{ACTIVITIES}, >= 0;
+  # Time by which each activity is shortened [in days]
+var crash{a in ACTIVITIES},
+     >= 0, <= normal_duration[a] - crash_duration[a];
+
+  # Resulting duration of each activity [in days]
+var actual_duration{a in ACTIVITIES} = normal_duration[a] - crash[a];
+
+###########################################################################
+# Objective function
+###########################################################################
+
+  # Minimize the total project cost
+minimize total_cost:
+    sum{a in ACTIVITIES} (normal_cost[a] + crash_slope[a]*crash[a]);
+
+###########################################################################
+# Precedence constraints
+###########################################################################
+
+  # A successor starts after its predecessor is finished
+subject to precedence{(i,j) in PRECEDENCES}:
+    start[i] + actual_duration[i] <= start[j];
+
+###########################################################################
+# Project completion
+###########################################################################
+
+  # Every activity finishes by the target project duration
+subject to completion{a in ACTIVITIES}:
+    start[a] + actual_duration[a] <= target_duration;
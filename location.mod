@@ -0,0 +1,85 @@
+# This model is part of the IEMS 313 project in Spring 2021 at Northwestern
+# University.
+#
+# AMPL model for facility location.
+#
+# The weighted center of gravity gives the location of a single facility.
+# The MILP solves the p-median problem of choosing p facilities among the
+# candidate sites.
+
+###########################################################################
+# Sets
+###########################################################################
+
+  # Set of demand points (customers)
+set CUSTOMERS;
+
+  # Set of candidate facility sites
+set SITES;
+
+###########################################################################
+# Parameters of the demand points and sites
+###########################################################################
+
+  # Coordinates of the demand points [in km]
+param x{CUSTOMERS};
+param y{CUSTOMERS};
+  # Demand weight of each demand point [in units]
+param weight{CUSTOMERS} >= 0;
+  # The total demand weight must be positive for the center of gravity
+check: sum{i in CUSTOMERS} weight[i] > 0;
+
+  # Coordinates of the candidate sites [in km]
+param site_x{SITES};
+param site_y{SITES};
+
+  # Distance between demand points and candidate sites [in km]
+  # If none is explicitly specified, the Euclidean distance is used.
+param distance{i in CUSTOMERS, j in SITES} >= 0,
+    default sqrt((x[i]-site_x[j])^2 + (y[i]-site_y[j])^2);
+
+  # Number of facilities to open
+param p integer, >= 1, <= card(SITES);
+
+###########################################################################
+# Single facility: center of gravity
+###########################################################################
+
+  # Weighted center of gravity of the demand points [in km]
+param cog_x := (sum{i in CUSTOMERS} weight[i]*x[i])
+               / (sum{i in CUSTOMERS} weight[i]);
+param cog_y := (sum{i in CUSTOMERS} weight[i]*y[i])
+               / (sum{i in CUSTOMERS} weight[i]);
+
+###########################################################################
+# Optimization variables
+###########################################################################
+
+  # 1 if a facility is opened at the site, 0 otherwise
+var open{SITES}, binary;
+  # Fraction of the demand of a demand point served from a site
+var assign{CUSTOMERS, SITES}, >= 0, <= 1;
+
+###########################################################################
+# Objective function
+###########################################################################
+
+  # Minimize the total demand-weighted distance
+minimize total_weighted_distance:
+    sum{i in CUSTOMERS, j in SITES} weight[i]*distance[i,j]*assign[i,j];
+
+###########################################################################
+# Assignment constraints
+###########################################################################
+
+  # Every demand point is fully served
+subject to serve_demand{i in CUSTOMERS}:
+    sum{j in SITES} assign[i,j] = 1;
+
+  # Demand can only be served from an open facility
+subject to open_site{i in CUSTOMERS, j in SITES}:
+    assign[i,j] <= open[j];
+
+  # Exactly p facilities are opened
+subject to num_facilities:
+    sum{j in SITES} open[j] = p;
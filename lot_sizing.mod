@@ -0,0 +1,71 @@
+# This model is part of the IEMS 313 project in Spring 2021 at Northwestern
+# University.
+#
+# AMPL model for the uncapacitated single-item dynamic lot sizing problem
+# (Wagner-Whitin). Solving this MILP gives the optimal ordering plan and
+# its cost.
+
+###########################################################################
+# Sets, defining the planning horizon
+###########################################################################
+
+  # Number of periods in the planning horizon
+param num_periods;
+
+  # Set of periods
+set PERIODS = 1..num_periods;
+
+###########################################################################
+# Parameters
+###########################################################################
+
+  # Demand in each period [in units]
+  # If none is explicitly specified, the demand is zero.
+param demand{PERIODS} >= 0, default 0;
+  # Fixed cost of placing an order in each period [in $]
+param setup_cost{PERIODS} >= 0;
+  # Cost of carrying one unit from a period into the next [in $/unit]
+param holding_cost{PERIODS} >= 0;
+  # Inventory on hand before the first period [in units]
+param initial_inventory >= 0, default 0;
+
+  # Remaining demand from a period to the end of the horizon [in units]
+  # Used as the (tight) upper bound on the order quantity.
+param remaining_demand{t in PERIODS} := sum{s in PERIODS: s >= t} demand[s];
+
+###########################################################################
+# Optimization variables
+###########################################################################
+
+  # Order quantity in each period [in units]
+var order{t in PERIODS}, >= 0, <= remaining_demand[t];
+  # Inventory at the end of each period [in units]
+var inventory{PERIODS}, >= 0;
+  # 1 if an order is placed in the period, 0 otherwise
+var setup{PERIODS}, binary;
+
+###########################################################################
+# Objective function
+###########################################################################
+
+  # Minimize the total setup and holding cost over the horizon
+minimize total_cost:
+    sum{t in PERIODS} (setup_cost[t]*setup[t] + holding_cost[t]*inventory[t]);
+
+###########################################################################
+# Inventory balance equations
+###########################################################################
+
+  # Inventory balance in each period
+subject to inventory_balance{t in PERIODS}:
+    (if t = 1 then initial_inventory else inventory[t-1]) + order[t] - demand[t]
+     =
+    inventory[t];
+
+###########################################################################
+# Setup forcing constraints
+###########################################################################
+
+  # An order can only be placed in a period with a setup
+subject to setup_forcing{t in PERIODS}:
+    order[t] <= remaining_demand[t] * setup[t];